@@ -1,10 +1,12 @@
 package conf
 
 import (
+	"encoding"
 	"fmt"
-	"os"
+	"net/url"
 	"reflect"
 	"strings"
+	"time"
 )
 
 // InvalidLoadError represents an error that occurs when trying to load configurations
@@ -50,7 +52,34 @@ func Load(v any) error {
 		return err
 	}
 
-	return load(reflect.ValueOf(v).Elem())
+	return load(reflect.ValueOf(v).Elem(), []Provider{EnvProvider{}})
+}
+
+// LoadFrom populates the provided structure with configuration values
+// obtained from the given providers, queried in order. The first provider
+// to report a value for a field's "conf" key wins; the tag's default value
+// is only used when none of the providers supply one.
+//
+// Example:
+//
+//	dotenv, err := conf.NewDotEnvProvider(".env")
+//	if err != nil {
+//	    log.Fatalf("failed to load .env: %v", err)
+//	}
+//
+//	var cfg Config
+//	if err := conf.LoadFrom(&cfg, conf.EnvProvider{}, dotenv); err != nil {
+//	    log.Fatalf("failed to load config: %v", err)
+//	}
+//
+// In this example, environment variables take precedence over values from
+// the .env file.
+func LoadFrom(v any, providers ...Provider) error {
+	if err := validateInput(v); err != nil {
+		return err
+	}
+
+	return load(reflect.ValueOf(v).Elem(), providers)
 }
 
 // validateInput checks if the provided value is a non-nil pointer
@@ -67,50 +96,113 @@ func validateInput(v any) error {
 }
 
 // load iterates over the fields of the structure and loads the values
-// from environment variables. If a field is a nested struct,
-// the function is called recursively.
-func load(v reflect.Value) error {
+// from the given providers, queried in order. Every field-level error is
+// collected rather than returned immediately, so a single Load call
+// reports every misconfigured field at once.
+func load(v reflect.Value, providers []Provider) error {
+	var errs []error
+	collect(v, providers, &errs, "")
+
+	if len(errs) > 0 {
+		return &AggregateError{Errors: errs}
+	}
+	return nil
+}
+
+// collect walks the fields of the structure, appending a ParseError or
+// RequiredFieldError to errs for each field that fails to load. prefix is
+// prepended to every "conf" env var name, and grows as nested structs tagged
+// with "conf-prefix" are descended into. A field with no "conf" tag that is
+// itself a struct is descended into recursively (a struct-typed field that
+// has a "conf" tag, such as time.Time, is set directly), as is a pointer-to-struct field
+// once at least one of its inner values is present. A slice-of-struct field
+// is resolved from "BASE_0_", "BASE_1_", ... indexed env vars, where BASE is
+// the field's own "conf" tag.
+func collect(v reflect.Value, providers []Provider, errs *[]error, prefix string) {
 	t := v.Type()
 
 	for i := 0; i < v.NumField(); i++ {
 		field := v.Field(i)
 		fieldType := t.Field(i)
+		confTag := fieldType.Tag.Get("conf")
 
-		if field.Kind() == reflect.Struct {
-			if err := load(field); err != nil {
-				return err
-			}
+		switch {
+		case confTag == "" && field.Kind() == reflect.Struct:
+			collect(field, providers, errs, prefix+fieldType.Tag.Get("conf-prefix"))
+			continue
+		case confTag == "" && field.Kind() == reflect.Pointer && field.Type().Elem().Kind() == reflect.Struct:
+			collectPointerStruct(field, fieldType, providers, errs, prefix)
+			continue
+		case confTag != "" && field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.Struct:
+			collectSliceOfStructs(field, fieldType, providers, errs, prefix)
 			continue
 		}
 
-		confTag := fieldType.Tag.Get("conf")
 		if confTag == "" {
 			continue
 		}
 
-		parts := strings.Split(confTag, ",")
-		envVar := parts[0]
-		defaultValue := ""
-		if len(parts) > 1 {
-			defaultValue = parts[1]
+		opts := parseTag(confTag)
+		envVar := prefix + opts.EnvVar
+
+		envValue, ok := lookupProviders(providers, envVar)
+		if !ok {
+			envValue = opts.Default
 		}
 
-		// Get the environment variable value
-		envValue := os.Getenv(envVar)
-		if envValue == "" {
-			envValue = defaultValue
+		if opts.Required && envValue == "" {
+			*errs = append(*errs, &RequiredFieldError{Field: fieldType.Name, Tag: confTag})
+			continue
 		}
 
-		if err := setField(field, envValue); err != nil {
-			return err
+		if err := setField(field, envValue, opts); err != nil {
+			*errs = append(*errs, &ParseError{Field: fieldType.Name, Tag: confTag, Value: envValue, Err: err})
 		}
 	}
+}
 
-	return nil
+// lookupProviders queries providers in order, returning the first value
+// found for key.
+func lookupProviders(providers []Provider, key string) (string, bool) {
+	for _, p := range providers {
+		if value, ok := p.Lookup(key); ok {
+			return value, true
+		}
+	}
+	return "", false
 }
 
-// setField sets the value of the field based on its type.
-func setField(field reflect.Value, envValue string) error {
+// setField sets the value of the field based on its type. The built-in
+// special-cased types are checked first, since some of them (time.Time, in
+// particular) also implement encoding.TextUnmarshaler with fixed formats
+// that would otherwise shadow options like the "layout=" tag. Only after
+// those checks does a field get the chance to parse envValue itself via
+// Setter or encoding.TextUnmarshaler.
+func setField(field reflect.Value, envValue string, opts tagOptions) error {
+	switch field.Interface().(type) {
+	case time.Duration:
+		return setDuration(field, envValue)
+	case time.Time:
+		return setTime(field, envValue, opts.Layout)
+	case *time.Location:
+		return setLocation(field, envValue)
+	case *url.URL:
+		return setURL(field, envValue)
+	}
+
+	if field.CanAddr() {
+		if setter, ok := field.Addr().Interface().(Setter); ok {
+			return setter.SetValue(envValue)
+		}
+		if unmarshaler, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			return unmarshaler.UnmarshalText([]byte(envValue))
+		}
+		if updatable, ok := field.Addr().Interface().(updatableField); ok {
+			_, _, err := updatable.reload(envValue, opts)
+			return err
+		}
+	}
+
 	switch field.Kind() {
 	case reflect.String:
 		field.SetString(envValue)
@@ -134,10 +226,12 @@ func setField(field reflect.Value, envValue string) error {
 		field.SetFloat(floatValue)
 	case reflect.Slice:
 		if field.Type().Elem().Kind() == reflect.String {
-			field.Set(reflect.ValueOf(strings.Split(envValue, ";")))
+			field.Set(reflect.ValueOf(strings.Split(envValue, opts.Sep)))
 		} else {
 			return fmt.Errorf("unsupported slice type: %s", field.Type())
 		}
+	case reflect.Map:
+		return setMap(field, envValue, opts.Sep)
 	default:
 		return fmt.Errorf("unsupported field type: %s", field.Kind())
 	}
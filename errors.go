@@ -0,0 +1,58 @@
+package conf
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseError reports a failure to convert a single field's resolved value
+// to its Go type.
+type ParseError struct {
+	Field string
+	Tag   string
+	Value string
+	Err   error
+}
+
+// Error returns a descriptive message about the parse failure.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("conf: failed to parse field %s (tag %q, value %q): %v", e.Field, e.Tag, e.Value, e.Err)
+}
+
+// Unwrap returns the underlying parsing error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// RequiredFieldError reports a field tagged "required" that was supplied
+// neither by a provider nor by the tag's default value.
+type RequiredFieldError struct {
+	Field string
+	Tag   string
+}
+
+// Error returns a descriptive message about the missing field.
+func (e *RequiredFieldError) Error() string {
+	return fmt.Sprintf("conf: required field %s (tag %q) has no value", e.Field, e.Tag)
+}
+
+// AggregateError collects every field-level error encountered while
+// loading a struct, rather than stopping at the first one.
+type AggregateError struct {
+	Errors []error
+}
+
+// Error returns a message listing every collected error.
+func (e *AggregateError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("conf: %d error(s) occurred:\n%s", len(e.Errors), strings.Join(messages, "\n"))
+}
+
+// Unwrap returns the collected errors, allowing errors.Is and errors.As to
+// inspect them individually.
+func (e *AggregateError) Unwrap() []error {
+	return e.Errors
+}
@@ -0,0 +1,37 @@
+package conf
+
+import (
+	"errors"
+	"testing"
+)
+
+type requiredTestConfig struct {
+	DBURL string `conf:"DB_URL,,required"`
+	Port  int    `conf:"PORT,not-a-number"`
+}
+
+func TestLoad_RequiredFieldMissing(t *testing.T) {
+	var cfg requiredTestConfig
+	err := Load(&cfg)
+	if err == nil {
+		t.Fatal("expected Load to fail for missing required field")
+	}
+
+	var aggErr *AggregateError
+	if !errors.As(err, &aggErr) {
+		t.Fatalf("expected an *AggregateError, got %T", err)
+	}
+	if len(aggErr.Errors) != 2 {
+		t.Fatalf("expected 2 collected errors, got %d: %v", len(aggErr.Errors), aggErr.Errors)
+	}
+
+	var reqErr *RequiredFieldError
+	if !errors.As(aggErr.Errors[0], &reqErr) || reqErr.Field != "DBURL" {
+		t.Errorf("expected first error to be a RequiredFieldError for DBURL, got %v", aggErr.Errors[0])
+	}
+
+	var parseErr *ParseError
+	if !errors.As(aggErr.Errors[1], &parseErr) || parseErr.Field != "Port" {
+		t.Errorf("expected second error to be a ParseError for Port, got %v", aggErr.Errors[1])
+	}
+}
@@ -0,0 +1,84 @@
+package conf
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// collectPointerStruct resolves a pointer-to-struct field. The pointer is
+// only allocated, and its fields loaded, when at least one inner "conf" key
+// (under prefix, extended by the field's own "conf-prefix" tag if any) is
+// actually supplied by providers; otherwise the field is left nil.
+func collectPointerStruct(field reflect.Value, fieldType reflect.StructField, providers []Provider, errs *[]error, prefix string) {
+	elemType := field.Type().Elem()
+	nestedPrefix := prefix + fieldType.Tag.Get("conf-prefix")
+
+	if !hasAnyValue(elemType, providers, nestedPrefix) {
+		return
+	}
+
+	if field.IsNil() {
+		field.Set(reflect.New(elemType))
+	}
+	collect(field.Elem(), providers, errs, nestedPrefix)
+}
+
+// collectSliceOfStructs resolves a slice-of-struct field whose "conf" tag
+// names the base env var (e.g. "SERVER"). Elements are read at increasing
+// indices using the "BASE_0_", "BASE_1_", ... prefix convention, stopping
+// at the first index that supplies no value for any inner field.
+func collectSliceOfStructs(field reflect.Value, fieldType reflect.StructField, providers []Provider, errs *[]error, prefix string) {
+	opts := parseTag(fieldType.Tag.Get("conf"))
+	base := prefix + opts.EnvVar
+	elemType := field.Type().Elem()
+
+	elems := reflect.MakeSlice(field.Type(), 0, 0)
+	for idx := 0; ; idx++ {
+		itemPrefix := fmt.Sprintf("%s_%d_", base, idx)
+		if !hasAnyValue(elemType, providers, itemPrefix) {
+			break
+		}
+
+		item := reflect.New(elemType).Elem()
+		collect(item, providers, errs, itemPrefix)
+		elems = reflect.Append(elems, item)
+	}
+
+	field.Set(elems)
+}
+
+// hasAnyValue reports whether any "conf"-tagged field reachable from t
+// (walking nested structs and pointer-to-struct fields under prefix) has a
+// value supplied by providers. It never calls setField, so it is safe to
+// use purely to decide whether a pointer should be allocated or a slice
+// index exists.
+func hasAnyValue(t reflect.Type, providers []Provider, prefix string) bool {
+	for i := 0; i < t.NumField(); i++ {
+		fieldType := t.Field(i)
+		confTag := fieldType.Tag.Get("conf")
+
+		switch {
+		case confTag == "" && fieldType.Type.Kind() == reflect.Struct:
+			if hasAnyValue(fieldType.Type, providers, prefix+fieldType.Tag.Get("conf-prefix")) {
+				return true
+			}
+			continue
+		case confTag == "" && fieldType.Type.Kind() == reflect.Pointer && fieldType.Type.Elem().Kind() == reflect.Struct:
+			if hasAnyValue(fieldType.Type.Elem(), providers, prefix+fieldType.Tag.Get("conf-prefix")) {
+				return true
+			}
+			continue
+		}
+
+		if confTag == "" {
+			continue
+		}
+
+		opts := parseTag(confTag)
+		if _, ok := lookupProviders(providers, prefix+opts.EnvVar); ok {
+			return true
+		}
+	}
+
+	return false
+}
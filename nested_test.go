@@ -0,0 +1,81 @@
+package conf
+
+import (
+	"os"
+	"testing"
+)
+
+type dbConfig struct {
+	Host string `conf:"HOST,localhost"`
+	Port int    `conf:"PORT,5432"`
+}
+
+type serverConfig struct {
+	Host string `conf:"HOST"`
+}
+
+type nestedTestConfig struct {
+	DB      dbConfig       `conf-prefix:"DB_"`
+	Cache   *dbConfig      `conf-prefix:"CACHE_"`
+	Servers []serverConfig `conf:"SERVER"`
+}
+
+func TestLoad_NestedPrefix(t *testing.T) {
+	os.Setenv("DB_HOST", "db.internal")
+	defer os.Unsetenv("DB_HOST")
+
+	var cfg nestedTestConfig
+	if err := Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if cfg.DB.Host != "db.internal" {
+		t.Errorf("expected DB.Host to be 'db.internal', got %s", cfg.DB.Host)
+	}
+	if cfg.DB.Port != 5432 {
+		t.Errorf("expected DB.Port to be 5432, got %d", cfg.DB.Port)
+	}
+}
+
+func TestLoad_PointerStructLazyAllocation(t *testing.T) {
+	var cfg nestedTestConfig
+	if err := Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.Cache != nil {
+		t.Fatalf("expected Cache to remain nil when no CACHE_ env vars are set")
+	}
+
+	os.Setenv("CACHE_HOST", "cache.internal")
+	defer os.Unsetenv("CACHE_HOST")
+
+	var cfg2 nestedTestConfig
+	if err := Load(&cfg2); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg2.Cache == nil {
+		t.Fatal("expected Cache to be allocated when CACHE_HOST is set")
+	}
+	if cfg2.Cache.Host != "cache.internal" {
+		t.Errorf("expected Cache.Host to be 'cache.internal', got %s", cfg2.Cache.Host)
+	}
+}
+
+func TestLoad_SliceOfStructsIndexed(t *testing.T) {
+	os.Setenv("SERVER_0_HOST", "one.internal")
+	os.Setenv("SERVER_1_HOST", "two.internal")
+	defer os.Unsetenv("SERVER_0_HOST")
+	defer os.Unsetenv("SERVER_1_HOST")
+
+	var cfg nestedTestConfig
+	if err := Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if len(cfg.Servers) != 2 {
+		t.Fatalf("expected 2 servers, got %d", len(cfg.Servers))
+	}
+	if cfg.Servers[0].Host != "one.internal" || cfg.Servers[1].Host != "two.internal" {
+		t.Errorf("expected servers [one.internal two.internal], got %v", cfg.Servers)
+	}
+}
@@ -0,0 +1,266 @@
+package conf
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Provider is implemented by configuration sources that can be queried for a
+// value by key. LoadFrom queries a chain of providers in order, using the
+// first one that reports a value as found.
+type Provider interface {
+	// Lookup returns the value associated with key and whether it was found.
+	Lookup(key string) (string, bool)
+}
+
+// EnvProvider resolves values from the process environment. It is the
+// provider Load uses by default.
+type EnvProvider struct{}
+
+// Lookup returns the value of the named environment variable.
+func (EnvProvider) Lookup(key string) (string, bool) {
+	return os.LookupEnv(key)
+}
+
+// DotEnvProvider resolves values from a dotenv-style file, where each line is
+// a "KEY=VALUE" pair. Blank lines and lines starting with "#" are ignored,
+// and values may be wrapped in single or double quotes.
+type DotEnvProvider struct {
+	values map[string]string
+}
+
+// NewDotEnvProvider reads and parses the dotenv file at path.
+func NewDotEnvProvider(path string) (*DotEnvProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("conf: failed to read dotenv file: %w", err)
+	}
+
+	values, err := parseDotEnv(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DotEnvProvider{values: values}, nil
+}
+
+// Lookup returns the value associated with key in the dotenv file.
+func (p *DotEnvProvider) Lookup(key string) (string, bool) {
+	value, ok := p.values[key]
+	return value, ok
+}
+
+// parseDotEnv parses the contents of a dotenv file into a key/value map.
+func parseDotEnv(data []byte) (map[string]string, error) {
+	values := make(map[string]string)
+
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		idx := strings.Index(line, "=")
+		if idx < 0 {
+			return nil, fmt.Errorf("conf: invalid dotenv syntax at line %d: %q", i+1, line)
+		}
+
+		key := strings.TrimSpace(line[:idx])
+		value := unquoteDotEnvValue(strings.TrimSpace(line[idx+1:]))
+		values[key] = value
+	}
+
+	return values, nil
+}
+
+// unquoteDotEnvValue strips a single layer of matching single or double
+// quotes from value, if present.
+func unquoteDotEnvValue(value string) string {
+	if len(value) >= 2 {
+		first, last := value[0], value[len(value)-1]
+		if (first == '"' && last == '"') || (first == '\'' && last == '\'') {
+			return value[1 : len(value)-1]
+		}
+	}
+	return value
+}
+
+// fileProvider resolves values from a decoded file by walking dotted paths
+// (e.g. "db.host") against a tree of nested maps.
+type fileProvider struct {
+	data map[string]any
+}
+
+// Lookup returns the value found by walking key's dot-separated segments
+// through the decoded file tree.
+func (p *fileProvider) Lookup(key string) (string, bool) {
+	var current any = p.data
+	for _, part := range strings.Split(key, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		current, ok = m[part]
+		if !ok {
+			return "", false
+		}
+	}
+
+	switch v := current.(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, true
+	case float64:
+		// encoding/json decodes every JSON number into a float64, and the
+		// default "%v"/"%g" formatting switches to exponential notation
+		// above 1e6 (e.g. 12345678 becomes "1.2345678e+07"), which then
+		// silently mis-parses as a much smaller integer. FormatFloat with
+		// 'f' and -1 precision renders the shortest round-tripping decimal
+		// form instead.
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	default:
+		return fmt.Sprintf("%v", v), true
+	}
+}
+
+// JSONProvider resolves values from a JSON file. A key is split on "." and
+// each segment selects a nested object, so the key is the field's "conf" key
+// (with any "conf-prefix"); paths are not derived from struct field names.
+type JSONProvider struct {
+	fileProvider
+}
+
+// NewJSONProvider reads and parses the JSON file at path.
+func NewJSONProvider(path string) (*JSONProvider, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("conf: failed to read json file: %w", err)
+	}
+
+	data := make(map[string]any)
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("conf: failed to parse json file: %w", err)
+	}
+
+	return &JSONProvider{fileProvider{data: data}}, nil
+}
+
+// YAMLProvider resolves values from a YAML file. A key is split on "." and
+// each segment selects a nested mapping, so the key is the field's "conf" key
+// (with any "conf-prefix"); paths are not derived from struct field names.
+type YAMLProvider struct {
+	fileProvider
+}
+
+// NewYAMLProvider reads and parses the YAML file at path.
+func NewYAMLProvider(path string) (*YAMLProvider, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("conf: failed to read yaml file: %w", err)
+	}
+
+	data := make(map[string]any)
+	if err := yaml.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("conf: failed to parse yaml file: %w", err)
+	}
+
+	return &YAMLProvider{fileProvider{data: data}}, nil
+}
+
+// TOMLProvider resolves values from a TOML file. A key is split on "." and
+// each segment selects a nested table, so the key is the field's "conf" key
+// (with any "conf-prefix"); paths are not derived from struct field names.
+type TOMLProvider struct {
+	fileProvider
+}
+
+// NewTOMLProvider reads and parses the TOML file at path.
+func NewTOMLProvider(path string) (*TOMLProvider, error) {
+	data := make(map[string]any)
+	if _, err := toml.DecodeFile(path, &data); err != nil {
+		return nil, fmt.Errorf("conf: failed to parse toml file: %w", err)
+	}
+
+	return &TOMLProvider{fileProvider{data: data}}, nil
+}
+
+// FlagProvider resolves values from a flag.FlagSet, automatically
+// registering a flag for every "conf" tagged field of v. The flag name is
+// derived from the field's env var name, lower-cased with underscores
+// replaced by dashes (e.g. "DB_HOST" becomes "-db-host").
+type FlagProvider struct {
+	values map[string]*string
+}
+
+// NewFlagProvider walks v registering a flag on fs for every "conf" tagged
+// field, then returns a Provider backed by the parsed flag values. The
+// caller is responsible for calling fs.Parse after construction.
+func NewFlagProvider(v any, fs *flag.FlagSet) (*FlagProvider, error) {
+	if err := validateInput(v); err != nil {
+		return nil, err
+	}
+
+	p := &FlagProvider{values: make(map[string]*string)}
+	registerFlags(reflect.ValueOf(v).Elem(), fs, p, "")
+
+	return p, nil
+}
+
+// registerFlags recursively registers a flag for every "conf" tagged field
+// of v on fs, recording a pointer to its parsed value in p. prefix is
+// prepended to every flag's env var name, growing as nested structs and
+// pointer-to-struct fields tagged with "conf-prefix" are descended into,
+// mirroring collect's traversal.
+//
+// Slice-of-struct fields (resolved by collect using "BASE_0_", "BASE_1_",
+// ... indexed env vars) are skipped: a flag.FlagSet must have every flag
+// registered before Parse is called, so the element count can't be known
+// ahead of time the way it can once providers are queried at Load time.
+func registerFlags(v reflect.Value, fs *flag.FlagSet, p *FlagProvider, prefix string) {
+	t := v.Type()
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		fieldType := t.Field(i)
+		confTag := fieldType.Tag.Get("conf")
+
+		switch {
+		case confTag == "" && field.Kind() == reflect.Struct:
+			registerFlags(field, fs, p, prefix+fieldType.Tag.Get("conf-prefix"))
+			continue
+		case confTag == "" && field.Kind() == reflect.Pointer && field.Type().Elem().Kind() == reflect.Struct:
+			registerFlags(reflect.New(field.Type().Elem()).Elem(), fs, p, prefix+fieldType.Tag.Get("conf-prefix"))
+			continue
+		case confTag != "" && field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.Struct:
+			continue
+		}
+
+		if confTag == "" {
+			continue
+		}
+
+		opts := parseTag(confTag)
+		envVar := prefix + opts.EnvVar
+		flagName := strings.ToLower(strings.ReplaceAll(envVar, "_", "-"))
+		p.values[envVar] = fs.String(flagName, "", fmt.Sprintf("overrides %s", envVar))
+	}
+}
+
+// Lookup returns the parsed flag value for key, if a flag was registered for
+// it and it was explicitly set to a non-empty value.
+func (p *FlagProvider) Lookup(key string) (string, bool) {
+	value, ok := p.values[key]
+	if !ok || *value == "" {
+		return "", false
+	}
+	return *value, true
+}
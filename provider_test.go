@@ -0,0 +1,169 @@
+package conf
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type providerTestConfig struct {
+	Host string `conf:"HOST,localhost"`
+	Port int    `conf:"PORT,8080"`
+}
+
+type flagProviderDBConfig struct {
+	Host string `conf:"HOST,localhost"`
+}
+
+type flagProviderTestConfig struct {
+	DB flagProviderDBConfig `conf-prefix:"DB_"`
+}
+
+func TestLoadFrom_Precedence(t *testing.T) {
+	os.Setenv("HOST", "env-host")
+	defer os.Unsetenv("HOST")
+
+	dotenvPath := filepath.Join(t.TempDir(), ".env")
+	if err := os.WriteFile(dotenvPath, []byte("HOST=dotenv-host\nPORT=9090\n"), 0o600); err != nil {
+		t.Fatalf("failed to write dotenv fixture: %v", err)
+	}
+
+	dotenv, err := NewDotEnvProvider(dotenvPath)
+	if err != nil {
+		t.Fatalf("NewDotEnvProvider failed: %v", err)
+	}
+
+	var cfg providerTestConfig
+	if err := LoadFrom(&cfg, EnvProvider{}, dotenv); err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+
+	if cfg.Host != "env-host" {
+		t.Errorf("expected Host to be 'env-host', got %s", cfg.Host)
+	}
+	if cfg.Port != 9090 {
+		t.Errorf("expected Port to be 9090, got %d", cfg.Port)
+	}
+}
+
+func TestDotEnvProvider_QuotingAndComments(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	contents := "# a comment\nHOST=\"quoted-host\"\n\nPORT='9090'\n"
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("failed to write dotenv fixture: %v", err)
+	}
+
+	p, err := NewDotEnvProvider(path)
+	if err != nil {
+		t.Fatalf("NewDotEnvProvider failed: %v", err)
+	}
+
+	if value, ok := p.Lookup("HOST"); !ok || value != "quoted-host" {
+		t.Errorf("expected HOST to be 'quoted-host', got %q (found=%t)", value, ok)
+	}
+	if value, ok := p.Lookup("PORT"); !ok || value != "9090" {
+		t.Errorf("expected PORT to be '9090', got %q (found=%t)", value, ok)
+	}
+}
+
+func TestJSONProvider_DottedPath(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	contents := `{"db":{"host":"json-host"}}`
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("failed to write json fixture: %v", err)
+	}
+
+	p, err := NewJSONProvider(path)
+	if err != nil {
+		t.Fatalf("NewJSONProvider failed: %v", err)
+	}
+
+	if value, ok := p.Lookup("db.host"); !ok || value != "json-host" {
+		t.Errorf("expected db.host to be 'json-host', got %q (found=%t)", value, ok)
+	}
+	if _, ok := p.Lookup("db.missing"); ok {
+		t.Errorf("expected db.missing to be absent")
+	}
+}
+
+func TestJSONProvider_LargeNumber(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	contents := `{"big_number":12345678}`
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("failed to write json fixture: %v", err)
+	}
+
+	p, err := NewJSONProvider(path)
+	if err != nil {
+		t.Fatalf("NewJSONProvider failed: %v", err)
+	}
+
+	if value, ok := p.Lookup("big_number"); !ok || value != "12345678" {
+		t.Errorf("expected big_number to be '12345678', got %q (found=%t)", value, ok)
+	}
+}
+
+func TestYAMLProvider_DottedPath(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	contents := "db:\n  host: yaml-host\n"
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("failed to write yaml fixture: %v", err)
+	}
+
+	p, err := NewYAMLProvider(path)
+	if err != nil {
+		t.Fatalf("NewYAMLProvider failed: %v", err)
+	}
+
+	if value, ok := p.Lookup("db.host"); !ok || value != "yaml-host" {
+		t.Errorf("expected db.host to be 'yaml-host', got %q (found=%t)", value, ok)
+	}
+	if _, ok := p.Lookup("db.missing"); ok {
+		t.Errorf("expected db.missing to be absent")
+	}
+}
+
+func TestTOMLProvider_DottedPath(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	contents := "[db]\nhost = \"toml-host\"\n"
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("failed to write toml fixture: %v", err)
+	}
+
+	p, err := NewTOMLProvider(path)
+	if err != nil {
+		t.Fatalf("NewTOMLProvider failed: %v", err)
+	}
+
+	if value, ok := p.Lookup("db.host"); !ok || value != "toml-host" {
+		t.Errorf("expected db.host to be 'toml-host', got %q (found=%t)", value, ok)
+	}
+	if _, ok := p.Lookup("db.missing"); ok {
+		t.Errorf("expected db.missing to be absent")
+	}
+}
+
+func TestFlagProvider_RegistersPrefixedNestedFlag(t *testing.T) {
+	var cfg flagProviderTestConfig
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+
+	provider, err := NewFlagProvider(&cfg, fs)
+	if err != nil {
+		t.Fatalf("NewFlagProvider failed: %v", err)
+	}
+	if err := fs.Parse([]string{"-db-host=flag-host"}); err != nil {
+		t.Fatalf("fs.Parse failed: %v", err)
+	}
+
+	if value, ok := provider.Lookup("DB_HOST"); !ok || value != "flag-host" {
+		t.Errorf("expected DB_HOST to be 'flag-host', got %q (found=%t)", value, ok)
+	}
+
+	if err := LoadFrom(&cfg, provider); err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+	if cfg.DB.Host != "flag-host" {
+		t.Errorf("expected DB.Host to be 'flag-host', got %s", cfg.DB.Host)
+	}
+}
@@ -0,0 +1,59 @@
+package conf
+
+import (
+	"strings"
+	"time"
+)
+
+// tagOptions holds the parsed contents of a "conf" struct tag, of the form
+// "VAR,default,opt1,opt2,...". Recognized options are "sep=" (the separator
+// used for slice and map fields, default ";"), "layout=" (the time layout
+// used for time.Time fields, default time.RFC3339), "required" (fail Load
+// if neither a provider nor the default supplies a value), "secret" (mask
+// the value in Usage and Dump output), and "updatable" (allow Watch to
+// refresh the field's value in place).
+//
+// Because options are comma-separated, a "layout=" value that itself
+// contains a comma (e.g. "Jan 2, 2006") cannot be expressed in a "conf" tag.
+// Use a layout without commas, or implement Setter on the field's type.
+type tagOptions struct {
+	EnvVar    string
+	Default   string
+	Sep       string
+	Layout    string
+	Required  bool
+	Secret    bool
+	Updatable bool
+}
+
+// parseTag parses the contents of a "conf" struct tag into a tagOptions.
+func parseTag(tag string) tagOptions {
+	parts := strings.Split(tag, ",")
+
+	opts := tagOptions{Sep: ";", Layout: time.RFC3339}
+	if len(parts) > 0 {
+		opts.EnvVar = parts[0]
+	}
+	if len(parts) > 1 {
+		opts.Default = parts[1]
+	}
+
+	if len(parts) > 2 {
+		for _, opt := range parts[2:] {
+			switch {
+			case strings.HasPrefix(opt, "sep="):
+				opts.Sep = strings.TrimPrefix(opt, "sep=")
+			case strings.HasPrefix(opt, "layout="):
+				opts.Layout = strings.TrimPrefix(opt, "layout=")
+			case opt == "required":
+				opts.Required = true
+			case opt == "secret":
+				opts.Secret = true
+			case opt == "updatable":
+				opts.Updatable = true
+			}
+		}
+	}
+
+	return opts
+}
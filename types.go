@@ -0,0 +1,86 @@
+package conf
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Setter is implemented by types that know how to parse their own string
+// representation. If a field's type implements Setter, conf defers to it
+// instead of its built-in type handling.
+type Setter interface {
+	SetValue(string) error
+}
+
+// setDuration parses value with time.ParseDuration and stores it in field.
+func setDuration(field reflect.Value, value string) error {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return fmt.Errorf("conf: invalid duration %q: %w", value, err)
+	}
+	field.Set(reflect.ValueOf(d))
+	return nil
+}
+
+// setTime parses value using layout and stores it in field.
+func setTime(field reflect.Value, value, layout string) error {
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return fmt.Errorf("conf: invalid time %q: %w", value, err)
+	}
+	field.Set(reflect.ValueOf(t))
+	return nil
+}
+
+// setLocation resolves value as an IANA time zone name and stores it in field.
+func setLocation(field reflect.Value, value string) error {
+	loc, err := time.LoadLocation(value)
+	if err != nil {
+		return fmt.Errorf("conf: invalid location %q: %w", value, err)
+	}
+	field.Set(reflect.ValueOf(loc))
+	return nil
+}
+
+// setURL parses value as a URL and stores it in field.
+func setURL(field reflect.Value, value string) error {
+	u, err := url.Parse(value)
+	if err != nil {
+		return fmt.Errorf("conf: invalid url %q: %w", value, err)
+	}
+	field.Set(reflect.ValueOf(u))
+	return nil
+}
+
+// setMap splits value into entries on sep and each entry into a key/value
+// pair on "=", converting both sides to the map's key and element types.
+func setMap(field reflect.Value, value, sep string) error {
+	m := reflect.MakeMap(field.Type())
+
+	if strings.TrimSpace(value) != "" {
+		for _, entry := range strings.Split(value, sep) {
+			kv := strings.SplitN(entry, "=", 2)
+			if len(kv) != 2 {
+				return fmt.Errorf("conf: invalid map entry %q", entry)
+			}
+
+			key := reflect.New(field.Type().Key()).Elem()
+			if err := setField(key, strings.TrimSpace(kv[0]), tagOptions{Sep: sep}); err != nil {
+				return err
+			}
+
+			elem := reflect.New(field.Type().Elem()).Elem()
+			if err := setField(elem, strings.TrimSpace(kv[1]), tagOptions{Sep: sep}); err != nil {
+				return err
+			}
+
+			m.SetMapIndex(key, elem)
+		}
+	}
+
+	field.Set(m)
+	return nil
+}
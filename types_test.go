@@ -0,0 +1,74 @@
+package conf
+
+import (
+	"net/url"
+	"os"
+	"testing"
+	"time"
+)
+
+type customID string
+
+func (c *customID) SetValue(value string) error {
+	*c = customID("id-" + value)
+	return nil
+}
+
+type extendedTestConfig struct {
+	Timeout  time.Duration     `conf:"TIMEOUT,1s"`
+	StartsAt time.Time         `conf:"STARTS_AT,2024-01-02T15:04:05Z"`
+	TZ       *time.Location    `conf:"TZ_NAME,UTC"`
+	Endpoint *url.URL          `conf:"ENDPOINT,https://example.com"`
+	Labels   map[string]string `conf:"LABELS,a=1;b=2"`
+	ID       customID          `conf:"ID,7"`
+}
+
+func TestLoad_ExtendedTypes(t *testing.T) {
+	var cfg extendedTestConfig
+	if err := Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if cfg.Timeout != time.Second {
+		t.Errorf("expected Timeout to be 1s, got %s", cfg.Timeout)
+	}
+	if cfg.StartsAt.Year() != 2024 {
+		t.Errorf("expected StartsAt year to be 2024, got %d", cfg.StartsAt.Year())
+	}
+	if cfg.TZ.String() != "UTC" {
+		t.Errorf("expected TZ to be UTC, got %s", cfg.TZ)
+	}
+	if cfg.Endpoint.Host != "example.com" {
+		t.Errorf("expected Endpoint host to be example.com, got %s", cfg.Endpoint.Host)
+	}
+	if cfg.Labels["a"] != "1" || cfg.Labels["b"] != "2" {
+		t.Errorf("expected Labels to be {a:1 b:2}, got %v", cfg.Labels)
+	}
+	if cfg.ID != "id-7" {
+		t.Errorf("expected ID to be 'id-7', got %s", cfg.ID)
+	}
+}
+
+func TestLoad_CustomSeparatorAndLayout(t *testing.T) {
+	os.Setenv("LABELS_PIPE", "a=1|b=2")
+	os.Setenv("STARTS_AT_CUSTOM", "2024-01-02")
+	defer os.Unsetenv("LABELS_PIPE")
+	defer os.Unsetenv("STARTS_AT_CUSTOM")
+
+	type cfg struct {
+		Labels   map[string]string `conf:"LABELS_PIPE,,sep=|"`
+		StartsAt time.Time         `conf:"STARTS_AT_CUSTOM,,layout=2006-01-02"`
+	}
+
+	var c cfg
+	if err := Load(&c); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if c.Labels["a"] != "1" || c.Labels["b"] != "2" {
+		t.Errorf("expected Labels to be {a:1 b:2}, got %v", c.Labels)
+	}
+	if c.StartsAt.Day() != 2 {
+		t.Errorf("expected StartsAt day to be 2, got %d", c.StartsAt.Day())
+	}
+}
@@ -0,0 +1,97 @@
+package conf
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"text/tabwriter"
+)
+
+// Usage walks v and writes a table of every configuration key to w, listing
+// its Go type, default value (from the "conf" tag), currently resolved
+// value, and description (from the "conf-desc" tag). Fields tagged "secret"
+// have their value masked.
+func Usage(v any, w io.Writer) error {
+	if err := validateInput(v); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "KEY\tTYPE\tDEFAULT\tVALUE\tDESCRIPTION")
+
+	walkConfig(reflect.ValueOf(v).Elem(), "", func(envVar string, field reflect.Value, fieldType reflect.StructField, opts tagOptions) {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", envVar, field.Type(), opts.Default, formatValue(field, opts), fieldType.Tag.Get("conf-desc"))
+	})
+
+	return tw.Flush()
+}
+
+// Dump returns the effective configuration of v as a map of env var name to
+// currently resolved value, masking the value of any field tagged "secret".
+// An invalid v (not a pointer to a struct) yields an empty map.
+func Dump(v any) map[string]string {
+	out := make(map[string]string)
+	if err := validateInput(v); err != nil {
+		return out
+	}
+
+	walkConfig(reflect.ValueOf(v).Elem(), "", func(envVar string, field reflect.Value, _ reflect.StructField, opts tagOptions) {
+		out[envVar] = formatValue(field, opts)
+	})
+
+	return out
+}
+
+// formatValue renders field's current value as a string, masking it with
+// "***" if opts.Secret is set. Fields like Reloadable[T] only expose their
+// wrapped value through a pointer-receiver String method, since field.Interface()
+// copies the struct (and its embedded sync.RWMutex) without it; those are
+// checked via field.Addr() before falling back to the default "%v" verb.
+func formatValue(field reflect.Value, opts tagOptions) string {
+	if opts.Secret {
+		return "***"
+	}
+	if field.CanAddr() {
+		if stringer, ok := field.Addr().Interface().(fmt.Stringer); ok {
+			return stringer.String()
+		}
+	}
+	return fmt.Sprintf("%v", field.Interface())
+}
+
+// walkConfig walks the "conf"-tagged fields reachable from v, descending
+// into nested structs, allocated pointer-to-struct fields, and slices of
+// structs the same way collect does, and invokes visit for each leaf field.
+func walkConfig(v reflect.Value, prefix string, visit func(envVar string, field reflect.Value, fieldType reflect.StructField, opts tagOptions)) {
+	t := v.Type()
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		fieldType := t.Field(i)
+		confTag := fieldType.Tag.Get("conf")
+
+		switch {
+		case confTag == "" && field.Kind() == reflect.Struct:
+			walkConfig(field, prefix+fieldType.Tag.Get("conf-prefix"), visit)
+			continue
+		case confTag == "" && field.Kind() == reflect.Pointer && field.Type().Elem().Kind() == reflect.Struct:
+			if !field.IsNil() {
+				walkConfig(field.Elem(), prefix+fieldType.Tag.Get("conf-prefix"), visit)
+			}
+			continue
+		case confTag != "" && field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.Struct:
+			base := prefix + parseTag(confTag).EnvVar
+			for idx := 0; idx < field.Len(); idx++ {
+				walkConfig(field.Index(idx), fmt.Sprintf("%s_%d_", base, idx), visit)
+			}
+			continue
+		}
+
+		if confTag == "" {
+			continue
+		}
+
+		opts := parseTag(confTag)
+		visit(prefix+opts.EnvVar, field, fieldType, opts)
+	}
+}
@@ -0,0 +1,67 @@
+package conf
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+type usageTestConfig struct {
+	Host     string `conf:"HOST,localhost" conf-desc:"the server host"`
+	APIToken string `conf:"API_TOKEN,,secret" conf-desc:"the API token"`
+}
+
+type usageUpdatableTestConfig struct {
+	Limit Reloadable[int] `conf:"LIMIT,99,updatable"`
+}
+
+func TestDump_MasksSecretFields(t *testing.T) {
+	os.Setenv("API_TOKEN", "super-secret")
+	defer os.Unsetenv("API_TOKEN")
+
+	var cfg usageTestConfig
+	if err := Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	dump := Dump(&cfg)
+	if dump["HOST"] != "localhost" {
+		t.Errorf("expected HOST to be 'localhost', got %q", dump["HOST"])
+	}
+	if dump["API_TOKEN"] != "***" {
+		t.Errorf("expected API_TOKEN to be masked, got %q", dump["API_TOKEN"])
+	}
+}
+
+func TestUsage_WritesTableWithDescriptions(t *testing.T) {
+	var cfg usageTestConfig
+	if err := Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := Usage(&cfg, &buf); err != nil {
+		t.Fatalf("Usage failed: %v", err)
+	}
+
+	output := buf.String()
+	if !strings.Contains(output, "HOST") || !strings.Contains(output, "the server host") {
+		t.Errorf("expected output to describe HOST, got:\n%s", output)
+	}
+	if !strings.Contains(output, "***") {
+		t.Errorf("expected output to mask API_TOKEN, got:\n%s", output)
+	}
+}
+
+func TestDump_RendersUpdatableFieldValue(t *testing.T) {
+	var cfg usageUpdatableTestConfig
+	if err := Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	dump := Dump(&cfg)
+	if dump["LIMIT"] != "99" {
+		t.Errorf("expected LIMIT to be '99', got %q", dump["LIMIT"])
+	}
+}
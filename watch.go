@@ -0,0 +1,152 @@
+package conf
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// Event describes a single field whose value changed during a Watch poll.
+type Event struct {
+	Field    string
+	EnvVar   string
+	OldValue string
+	NewValue string
+}
+
+// Reloadable wraps a value of type T so Watch can update it concurrently
+// with readers, guarding access with a sync.RWMutex. Struct fields that
+// should be hot-reloadable must be declared as a Reloadable[T] and tagged
+// with the "updatable" option.
+type Reloadable[T any] struct {
+	mu    sync.RWMutex
+	value T
+}
+
+// NewReloadable returns a Reloadable initialized with value.
+func NewReloadable[T any](value T) *Reloadable[T] {
+	return &Reloadable[T]{value: value}
+}
+
+// Load returns the current value.
+func (r *Reloadable[T]) Load() T {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.value
+}
+
+// Store atomically replaces the current value.
+func (r *Reloadable[T]) Store(value T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.value = value
+}
+
+// String implements fmt.Stringer, rendering the wrapped value so callers
+// like formatValue don't format the Reloadable struct itself (and its
+// embedded sync.RWMutex) via the default "%v" verb.
+func (r *Reloadable[T]) String() string {
+	return fmt.Sprintf("%v", r.Load())
+}
+
+// reload parses value into the wrapped T using opts and stores it under
+// the write lock, returning the value's string representation before and
+// after the update. It implements the unexported updatableField interface
+// so Watch (and Load) can update a Reloadable[T] field without knowing T.
+func (r *Reloadable[T]) reload(value string, opts tagOptions) (oldValue, newValue string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	oldValue = fmt.Sprintf("%v", r.value)
+
+	fv := reflect.ValueOf(&r.value).Elem()
+	if err := setField(fv, value, opts); err != nil {
+		return oldValue, oldValue, err
+	}
+
+	return oldValue, fmt.Sprintf("%v", r.value), nil
+}
+
+// updatableField is implemented by Reloadable[T], letting conf update its
+// value without needing to know T.
+type updatableField interface {
+	reload(value string, opts tagOptions) (oldValue, newValue string, err error)
+}
+
+// Watch periodically re-reads providers (currently just the environment)
+// and updates any field of v tagged with the "updatable" option in place,
+// every interval, until ctx is done. It returns a channel on which an Event
+// is sent for each field whose resolved value changes; the channel is
+// closed once watching stops. Fields without "updatable" are left
+// untouched, so restart-only settings (like listen ports) can't drift.
+func Watch(ctx context.Context, v any, interval time.Duration) (<-chan Event, error) {
+	if err := validateInput(v); err != nil {
+		return nil, err
+	}
+
+	root := reflect.ValueOf(v).Elem()
+	providers := []Provider{EnvProvider{}}
+	events := make(chan Event)
+
+	go func() {
+		defer close(events)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if !reloadUpdatable(ctx, root, providers, events) {
+					return
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// reloadUpdatable walks the "conf"-tagged fields reachable from v the same
+// way Load does, updating any field tagged "updatable" whose resolved value
+// has changed and sending an Event describing the change. events is
+// unbuffered, so every send races ctx being canceled; reloadUpdatable
+// reports whether it finished without ctx being canceled, so Watch's caller
+// can stop polling instead of blocking forever on a consumer that stopped
+// draining events.
+func reloadUpdatable(ctx context.Context, v reflect.Value, providers []Provider, events chan<- Event) bool {
+	done := false
+
+	walkConfig(v, "", func(envVar string, field reflect.Value, fieldType reflect.StructField, opts tagOptions) {
+		if done || !opts.Updatable || !field.CanAddr() {
+			return
+		}
+
+		updatable, ok := field.Addr().Interface().(updatableField)
+		if !ok {
+			return
+		}
+
+		value, found := lookupProviders(providers, envVar)
+		if !found {
+			value = opts.Default
+		}
+
+		oldValue, newValue, err := updatable.reload(value, opts)
+		if err != nil || oldValue == newValue {
+			return
+		}
+
+		select {
+		case events <- Event{Field: fieldType.Name, EnvVar: envVar, OldValue: oldValue, NewValue: newValue}:
+		case <-ctx.Done():
+			done = true
+		}
+	})
+
+	return !done
+}
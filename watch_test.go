@@ -0,0 +1,111 @@
+package conf
+
+import (
+	"context"
+	"os"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type watchTestConfig struct {
+	Message Reloadable[string] `conf:"MESSAGE,hello,updatable"`
+	Port    int                `conf:"PORT,8080"`
+}
+
+func TestWatch_UpdatesUpdatableField(t *testing.T) {
+	var cfg watchTestConfig
+	if err := Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.Message.Load() != "hello" {
+		t.Fatalf("expected initial Message to be 'hello', got %s", cfg.Message.Load())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := Watch(ctx, &cfg, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("Watch failed: %v", err)
+	}
+
+	os.Setenv("MESSAGE", "updated")
+	defer os.Unsetenv("MESSAGE")
+
+	select {
+	case ev := <-events:
+		if ev.EnvVar != "MESSAGE" || ev.NewValue != "updated" {
+			t.Errorf("expected MESSAGE updated to 'updated', got %+v", ev)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for update event")
+	}
+
+	if cfg.Message.Load() != "updated" {
+		t.Errorf("expected Message to be 'updated', got %s", cfg.Message.Load())
+	}
+	if cfg.Port != 8080 {
+		t.Errorf("expected Port to remain 8080, got %d", cfg.Port)
+	}
+}
+
+type watchLeakTestConfig struct {
+	Counter Reloadable[string] `conf:"COUNTER,0,updatable"`
+}
+
+// TestWatch_StopsWithoutLeakingWhenConsumerStopsDraining reproduces a
+// consumer that cancels ctx and stops reading from events entirely. Without
+// a select on ctx.Done() around the event send, the watcher goroutine would
+// block forever trying to deliver an event nobody is reading, leaking the
+// goroutine and its ticker for the life of the process.
+func TestWatch_StopsWithoutLeakingWhenConsumerStopsDraining(t *testing.T) {
+	os.Setenv("COUNTER", "0")
+	defer os.Unsetenv("COUNTER")
+
+	var cfg watchLeakTestConfig
+	if err := Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := Watch(ctx, &cfg, 2*time.Millisecond)
+	if err != nil {
+		t.Fatalf("Watch failed: %v", err)
+	}
+	_ = events // never drained, on purpose
+
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(time.Millisecond)
+		defer ticker.Stop()
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				os.Setenv("COUNTER", strconv.Itoa(i))
+			}
+		}
+	}()
+
+	// Give the watcher goroutine time to poll, find a change, and block
+	// trying to send an event that nothing is reading.
+	time.Sleep(30 * time.Millisecond)
+	cancel()
+	close(stop)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if runtime.NumGoroutine() <= before+1 {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("goroutine count stayed elevated after cancel: before=%d now=%d", before, runtime.NumGoroutine())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}